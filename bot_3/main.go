@@ -160,6 +160,273 @@ bot.HandleFunc(func(ctx context.Context) error {
 
 
 
+//go run ./examples/poller/main.go -debug -token BOT_TOKEN
+
+// Offset is read from the store at startup and committed
+// only after the handler returns, so updates are not lost on restart.
+store := telegram.NewFileOffsetStore("offset.json")
+poller := telegram.NewPoller(api, telegram.UpdateCfg{
+    Timeout: 10, // Timeout in seconds for long polling.
+}, store)
+
+err := poller.Serve(ctx, func(ctx context.Context, update telegram.Update) error {
+    if update.Message == nil {
+        return nil
+    }
+    msg := telegram.CloneMessage(update.Message, nil)
+    _, err := api.Send(ctx, msg)
+    return err
+})
+
+
+
+
+//go run ./examples/shutdown/main.go -debug -token BOT_TOKEN
+
+go func() {
+    sig := make(chan os.Signal, 1)
+    signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+    <-sig
+    // stop fetching updates and give handlers 10 seconds to finish
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+    if err := bot.Shutdown(ctx); err != nil {
+        log.Printf("shutdown error: %v", err)
+    }
+}()
+
+// Serve returns nil after Shutdown
+if err := bot.Serve(netCtx); err != nil {
+    log.Fatal(err)
+}
+
+
+
+
+//go run ./examples/webhook/main.go -debug -token BOT_TOKEN
+
+// self-signed certificate, no need to run openssl by hand
+cert, err := telegram.NewSelfSignedCert(telegram.CertOptions{
+    Hosts: []string{"www.example.com"},
+})
+if err != nil {
+    log.Fatal(err)
+}
+
+wh := telegram.NewWebhookWithCert(
+    "https://www.example.com:8443/updates",
+    cert.File(),
+)
+wh.SecretToken = "MySecretToken" // sent back in every webhook request
+if err := api.SetWebhook(ctx, wh); err != nil {
+    log.Fatal(err)
+}
+
+updatesCh := make(chan telegram.Update)
+// bot token is not a part of the path, requests are verified
+// by X-Telegram-Bot-Api-Secret-Token header instead
+http.Handle("/updates", telegram.NewWebhookHandler(wh.SecretToken, updatesCh))
+tlsConfig, err := cert.TLSConfig()
+if err != nil {
+    log.Fatal(err)
+}
+srv := &http.Server{Addr: "0.0.0.0:8443", TLSConfig: tlsConfig}
+go srv.ListenAndServeTLS("", "")
+
+for update := range updatesCh {
+    log.Printf("%+v\n", update)
+}
+
+
+
+
+//go run ./examples/source/main.go -debug -token BOT_TOKEN -mode webhook
+
+mux := http.NewServeMux()
+mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+    w.WriteHeader(http.StatusOK)
+})
+
+// the same handler works with long polling and webhooks
+source := telegram.NewSupervisor(api, telegram.SourceOptions{
+    Mode:    *mode, // telegram.PollingMode or telegram.WebhookMode
+    Update:  telegram.UpdateCfg{Timeout: 10},
+    Webhook: wh,
+    // webhook handler is added to /updates, /health keeps working
+    Mux: mux,
+    Server: &http.Server{
+        Addr:      "0.0.0.0:8443",
+        Handler:   mux,
+        TLSConfig: tlsConfig,
+    },
+    // switch to polling if Telegram can't deliver updates to the webhook
+    MaxWebhookErrors: 3,
+})
+
+err := source.Serve(ctx, func(ctx context.Context, update telegram.Update) error {
+    log.Printf("%+v\n", update)
+    return nil
+})
+
+
+
+
+//go run ./examples/router/main.go -debug -token BOT_TOKEN
+
+me, err := api.GetMe(ctx)
+if err != nil {
+    log.Fatal(err)
+}
+// commands addressed to other bots, e.g. /start@otherbot, are ignored
+router := telebot.NewRouter(me.Username)
+
+router.Command("start", func(ctx context.Context) error {
+    // matches /start and /start@<me.Username>
+    update := telebot.GetUpdate(ctx)
+    msg := telegram.NewMessage(update.Chat().ID, "Your sex:")
+    msg.ReplyMarkup = telegram.InlineKeyboardMarkup{
+        InlineKeyboard: telegram.NewVInlineKeyboard(
+            "sex:",
+            []string{"Female", "Male",},
+            []string{"female", "male",},
+        ),
+    }
+    _, err := telebot.GetAPI(ctx).SendMessage(ctx, msg)
+    return err
+}).ChatType(telegram.PrivateChatType)
+
+router.CallbackPattern("sex:{sex}", func(ctx context.Context) error {
+    update := telebot.GetUpdate(ctx)
+    api := telebot.GetAPI(ctx)
+    cfg := telegram.NewEditMessageText(
+        update.Chat().ID,
+        update.CallbackQuery.Message.MessageID,
+        fmt.Sprintf("You sex: %s", telebot.Params(ctx)["sex"]),
+    )
+    api.AnswerCallbackQuery(
+        ctx,
+        telegram.NewAnswerCallback(
+            update.CallbackQuery.ID,
+            "Your configs changed",
+        ),
+    )
+    _, err := api.EditMessageText(ctx, cfg)
+    return err
+})
+
+router.Content(telebot.LocationContent, func(ctx context.Context) error {
+    update := telebot.GetUpdate(ctx)
+    log.Printf("location: %+v", update.Message.Location)
+    return nil
+})
+
+router.Fallback(func(ctx context.Context) error {
+    return nil // ignore everything else
+})
+
+bot.Use(router.Middleware())
+
+
+
+
+//go run ./examples/commands/main.go -debug -token BOT_TOKEN
+
+type remindArgs struct {
+    After time.Duration `arg:"after"`
+    Text  string        `arg:"text"`  // "quoted text" is one argument
+    Chat  *int64        `flag:"chat"` // optional -chat=123
+}
+
+remind := telebot.CommandSpec{
+    Name:        "remind",
+    Description: "remind me about something",
+    Args:        remindArgs{},
+    Handler: func(ctx context.Context, args interface{}) error {
+        // /remind 1h30m "call mom"
+        a := args.(*remindArgs)
+        log.Printf("remind %q after %s", a.Text, a.After)
+        return nil
+    },
+}
+
+// bad arguments are answered with a usage message
+bot.Use(telebot.Commands(telebot.CommandSpecs(remind)))
+
+// descriptions for the bot command menu
+menu := telebot.BotCommands(remind)
+
+
+
+
+//go run ./examples/menu/main.go -debug -token BOT_TOKEN
+
+commands := map[string]telebot.Commander{
+    "start": telebot.Describe("start the bot", telebot.CommandFunc(
+        func(ctx context.Context, arg string) error {
+            return nil
+        })),
+    "remind": remind, // CommandSpec has its own description
+}
+bot.Use(telebot.Commands(commands))
+
+// the command menu is updated only if it differs from the map,
+// commands without description are handled but not shown in the menu
+err := telebot.SyncCommands(ctx, api, nil, "", commands)
+
+
+
+
+//go run ./examples/conversation/main.go -debug -token BOT_TOKEN
+
+conv := telebot.NewConversation("location", telebot.NewMemoryStateStore())
+
+conv.State("location", telebot.State{
+    Prompt: func(ctx context.Context) error {
+        update := telebot.GetUpdate(ctx)
+        msg := telegram.NewMessage(update.Chat().ID, "Hi")
+        msg.ReplyMarkup = telegram.ReplyKeyboardMarkup{
+            Keyboard: [][]telegram.KeyboardButton{{{
+                Text:            "Gimme where u live!!",
+                RequestLocation: true,
+            }}},
+        }
+        _, err := telebot.GetAPI(ctx).SendMessage(ctx, msg)
+        return err
+    },
+    OnLocation: func(ctx context.Context, loc telegram.Location) (string, error) {
+        data := telebot.ConversationData(ctx)
+        data["location"] = fmt.Sprintf("%f,%f", loc.Latitude, loc.Longitude)
+        return "name", nil
+    },
+    Timeout: 5 * time.Minute, // forget about the user after 5 minutes
+})
+
+conv.State("name", telebot.State{
+    Prompt: func(ctx context.Context) error {
+        update := telebot.GetUpdate(ctx)
+        _, err := telebot.GetAPI(ctx).SendMessage(ctx,
+            telegram.NewMessage(update.Chat().ID, "What is your name?"))
+        return err
+    },
+    OnText: func(ctx context.Context, text string) (string, error) {
+        log.Printf("%s lives at %s", text, telebot.ConversationData(ctx)["location"])
+        return telebot.EndState, nil
+    },
+})
+
+// /cancel finishes the conversation in any state
+bot.Use(conv.Middleware())
+
+bot.Use(telebot.Commands(map[string]telebot.Commander{
+    "start": telebot.CommandFunc(
+        func(ctx context.Context, arg string) error {
+            return conv.Start(ctx)
+        }),
+}))
+
+
+
+
 //View Source
 const (
 	// APIEndpoint is the endpoint for all API methods,
@@ -167,6 +434,34 @@ const (
 	APIEndpoint = "https://api.telegram.org/bot%s/%s"
 	// FileEndpoint is the endpoint for downloading a file from Telegram.
 	FileEndpoint = "https://api.telegram.org/file/bot%s/%s"
+	// LocalAPIEndpoint is the default endpoint
+	// of a local Bot API server started with --local flag.
+	LocalAPIEndpoint = "http://localhost:8081/bot%s/%s"
+	// LocalFileEndpoint is the endpoint for downloading a file
+	// from a local Bot API server without direct access to its files.
+	LocalFileEndpoint = "http://localhost:8081/file/bot%s/%s"
+)
+
+//View Source
+const (
+	// SecretTokenHeader is a header Telegram sends in every webhook request
+	// with the value of WebhookCfg.SecretToken.
+	SecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+	// DefaultWebhookBodySize is a default limit
+	// of webhook request body in bytes.
+	DefaultWebhookBodySize = 1 << 20
+)
+
+//View Source
+const (
+	RSAKeyType   = "rsa"
+	ECDSAKeyType = "ecdsa"
+)
+
+//View Source
+const (
+	PollingMode = "polling"
+	WebhookMode = "webhook"
 )
 
 //View Source
@@ -201,24 +496,38 @@ find_location for location data
 
 //View Source
 const (
-	MarkdownMode = "Markdown"
-	HTMLMode     = "HTML"
+	MarkdownMode   = "Markdown"
+	MarkdownV2Mode = "MarkdownV2"
+	HTMLMode       = "HTML"
+)
+
+//View Source
+const (
+	// MaxMessageLength is a maximum length of message text
+	// in UTF-16 code units after entities parsing.
+	MaxMessageLength = 4096
+	// MaxCaptionLength is a maximum length of media caption
+	// in UTF-16 code units after entities parsing.
+	MaxCaptionLength = 1024
 )
 
 //View Source
 const (
 	// @username
-	MentionEntityType     = "mention"
-	HashTagEntityType     = "hashtag"
-	BotCommandEntityType  = "bot_command"
-	URLEntityType         = "url"
-	EmailEntityType       = "email"
-	BoldEntityType        = "bold"         // bold text
-	ItalicEntityType      = "italic"       // italic text
-	CodeEntityType        = "code"         // monowidth string
-	PreEntityType         = "pre"          // monowidth block
-	TextLinkEntityType    = "text_link"    // for clickable text URLs
-	TextMentionEntityType = "text_mention" // for users without usernames
+	MentionEntityType       = "mention"
+	HashTagEntityType       = "hashtag"
+	BotCommandEntityType    = "bot_command"
+	URLEntityType           = "url"
+	EmailEntityType         = "email"
+	BoldEntityType          = "bold"          // bold text
+	ItalicEntityType        = "italic"        // italic text
+	UnderlineEntityType     = "underline"     // underlined text
+	StrikethroughEntityType = "strikethrough" // strikethrough text
+	SpoilerEntityType       = "spoiler"       // spoiler message
+	CodeEntityType          = "code"          // monowidth string
+	PreEntityType           = "pre"           // monowidth block
+	TextLinkEntityType      = "text_link"     // for clickable text URLs
+	TextMentionEntityType   = "text_mention"  // for users without usernames
 )
 
 //View Source
@@ -230,11 +539,64 @@ const (
 	KickedMemberStatus        = "kicked"
 )
 
+//View Source
+const (
+	MessageUpdateType            = "message"
+	EditedMessageUpdateType      = "edited_message"
+	ChannelPostUpdateType        = "channel_post"
+	EditedChannelPostUpdateType  = "edited_channel_post"
+	InlineQueryUpdateType        = "inline_query"
+	ChosenInlineResultUpdateType = "chosen_inline_result"
+	CallbackQueryUpdateType      = "callback_query"
+	ShippingQueryUpdateType      = "shipping_query"
+	PreCheckoutQueryUpdateType   = "pre_checkout_query"
+	PollUpdateType               = "poll"
+	PollAnswerUpdateType         = "poll_answer"
+	MyChatMemberUpdateType       = "my_chat_member"
+	ChatMemberUpdateType         = "chat_member"
+	ChatJoinRequestUpdateType    = "chat_join_request"
+)
+
+//View Source
+const (
+	DefaultScopeType               = "default"
+	AllPrivateChatsScopeType       = "all_private_chats"
+	AllGroupChatsScopeType         = "all_group_chats"
+	AllChatAdministratorsScopeType = "all_chat_administrators"
+	ChatScopeType                  = "chat"
+	ChatAdministratorsScopeType    = "chat_administrators"
+	ChatMemberScopeType            = "chat_member"
+)
+
+//View Source
+const (
+	PhotoMediaType    = "photo"
+	VideoMediaType    = "video"
+	DocumentMediaType = "document"
+	AudioMediaType    = "audio"
+)
+
 //View Source
 var DefaultDebugFunc = func(msg string, fields map[string]interface{}) {
 	log.Printf("%s %v", msg, fields)
 }
 
+//View Source
+var DefaultRateLimits = RateLimits{
+	Global:  30,        // messages per second to all chats
+	Private: 1,         // messages per second to a single private chat
+	Group:   20.0 / 60, // 20 messages per minute to a group or channel
+	Burst:   1,
+}
+
+//
+//      
+func EscapeText(parseMode string, text string) string
+
+//
+//      
+func FileKey(kind string, file InputFile) (string, error)
+
 //
 //      
 func GetUpdates(
@@ -247,6 +609,14 @@ func GetUpdates(
 //      
 func IsAPIError(err error) bool
 
+//
+//      
+func IsFileSizeError(err error) bool
+
+//
+//      
+func IsFloodWaitError(err error) bool
+
 //
 //      
 func IsForbiddenError(err error) bool
@@ -287,6 +657,34 @@ func NewVInlineKeyboard(prefix string, text []string, data []string) [][]InlineK
 //      
 func NewVKeyboard(buttons ...string) [][]KeyboardButton
 
+//
+//      
+func ParseHTML(s string) (string, []MessageEntity, error)
+
+//
+//      
+func RenderHTML(text string, entities []MessageEntity) string
+
+//
+//      
+func RenderMarkdownV2(text string, entities []MessageEntity) string
+
+//
+//      
+func SplitMessage(cfg MessageCfg, limit int) ([]MessageCfg, error)
+
+//
+//      
+func StringIndex(s string, offset int) int
+
+//
+//      
+func UTF16Len(s string) int
+
+//
+//      
+func UTF16Offset(s string, index int) int
+
 //
 //      
 type API struct {
@@ -295,11 +693,11 @@ type API struct {
 
 //
 //      
-func New(token string) *API
+func New(token string, opts ...Option) *API
 
 //
 //      
-func NewWithClient(token string, client HTTPDoer) *API
+func NewWithClient(token string, client HTTPDoer, opts ...Option) *API
 
 //
 //      
@@ -311,6 +709,14 @@ func (c *API) AnswerCallbackQuery(
 //      
 func (c *API) AnswerInlineQuery(ctx context.Context, cfg AnswerInlineQueryCfg) (bool, error)
 
+//
+//      
+func (c *API) CacheFileIDs(cache FileIDCache)
+
+//
+//      
+func (c *API) Close(ctx context.Context) (bool, error)
+
 //
 //      
 func (c *API) Debug(val bool)
@@ -319,10 +725,39 @@ func (c *API) Debug(val bool)
 //      
 func (c *API) DebugFunc(f DebugFunc)
 
+//
+//      
+func (c *API) DeleteMyCommands(
+	ctx context.Context,
+	cfg DeleteMyCommandsCfg) (bool, error)
+
+//
+//      
+func (c *API) DeleteWebhook(
+	ctx context.Context,
+	cfg DeleteWebhookCfg) (bool, error)
+
 //
 //      
 func (c *API) DownloadFile(ctx context.Context, cfg FileCfg, w io.Writer) error
 
+//
+//      
+func (c *API) DownloadFileAt(
+	ctx context.Context,
+	cfg FileCfg,
+	w io.WriterAt,
+	offset int64,
+	opts DownloadOptions) (int64, error)
+
+//
+//      
+func (c *API) DownloadToFile(
+	ctx context.Context,
+	cfg FileCfg,
+	path string,
+	opts DownloadOptions) (*File, error)
+
 //
 //      
 func (c *API) Edit(ctx context.Context, cfg Method) (*EditResult, error)
@@ -381,6 +816,12 @@ func (c *API) GetFile(ctx context.Context, cfg FileCfg) (*File, error)
 //      
 func (c *API) GetMe(ctx context.Context) (*User, error)
 
+//
+//      
+func (c *API) GetMyCommands(
+	ctx context.Context,
+	cfg GetMyCommandsCfg) ([]BotCommand, error)
+
 //
 //      
 func (c *API) GetUpdates(
@@ -393,10 +834,18 @@ func (c *API) GetUserProfilePhotos(
 	ctx context.Context,
 	cfg UserProfilePhotosCfg) (*UserProfilePhotos, error)
 
+//
+//      
+func (c *API) GetWebhookInfo(ctx context.Context) (*WebhookInfo, error)
+
 //
 //      
 func (c *API) Invoke(ctx context.Context, m Method, dst interface{}) error
 
+//
+//      
+func (c *API) KeepRaw(val bool)
+
 //
 //      
 func (c *API) KickChatMember(
@@ -409,6 +858,18 @@ func (c *API) LeaveChat(
 	ctx context.Context,
 	cfg LeaveChatCfg) (bool, error)
 
+//
+//      
+func (c *API) Limiter(l Limiter)
+
+//
+//      
+func (c *API) LogOut(ctx context.Context) (bool, error)
+
+//
+//      
+func (c *API) Retry(policy *RetryPolicy)
+
 //
 //      
 func (c *API) Send(ctx context.Context, cfg Messenger) (*Message, error)
@@ -435,6 +896,18 @@ func (c *API) SendDocument(
 	ctx context.Context,
 	cfg DocumentCfg) (*Message, error)
 
+//
+//      
+func (c *API) SendLongMessage(
+	ctx context.Context,
+	cfg MessageCfg) ([]Message, error)
+
+//
+//      
+func (c *API) SendMediaGroup(
+	ctx context.Context,
+	cfg MediaGroupCfg) ([]Message, error)
+
 //
 //      
 func (c *API) SendMessage(
@@ -471,6 +944,12 @@ func (c *API) SendVoice(
 	ctx context.Context,
 	cfg VoiceCfg) (*Message, error)
 
+//
+//      
+func (c *API) SetMyCommands(
+	ctx context.Context,
+	cfg SetMyCommandsCfg) (bool, error)
+
 //
 //      
 func (c *API) SetWebhook(ctx context.Context, cfg WebhookCfg) error
@@ -481,12 +960,22 @@ func (c *API) UnbanChatMember(
 	ctx context.Context,
 	cfg UnbanChatMemberCfg) (bool, error)
 
+//
+//      
+// UploadProgress sets a callback called while multipart bodies
+// are streamed. If ctx is cancelled mid-upload, the request is
+// aborted, the body is not read any further and the Send* method
+// returns ctx.Err().
+func (c *API) UploadProgress(f ProgressFunc)
+
 //
 //      
 type APIError struct {
 	Description string `json:"description"`
 	// ErrorCode contents are subject to change in the future.
 	ErrorCode int `json:"error_code"`
+	// Parameters can help to automatically handle the error. Optional.
+	Parameters *ResponseParameters `json:"parameters,omitempty"`
 }
 
 //
@@ -496,10 +985,11 @@ func (e *APIError) Error() string
 //
 //      
 type APIResponse struct {
-	Ok          bool             `json:"ok"`
-	Result      *json.RawMessage `json:"result"`
-	ErrorCode   int              `json:"error_code,omitempty"`
-	Description string           `json:"description,omitempty"`
+	Ok          bool                `json:"ok"`
+	Result      *json.RawMessage    `json:"result"`
+	ErrorCode   int                 `json:"error_code,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Parameters  *ResponseParameters `json:"parameters,omitempty"`
 }
 
 //
@@ -648,6 +1138,10 @@ type BaseFile struct {
 	FileID    string
 	MimeType  string
 	InputFile InputFile
+	// CacheKey replaces the content hash of InputFile
+	// in the FileIDCache key, e.g. for files created by NewInputFile,
+	// which can't be hashed before the upload. Optional.
+	CacheKey string
 }
 
 //
@@ -680,6 +1174,41 @@ type BaseInlineQueryResult struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup,omitempty"`
 }
 
+//
+//      
+type BaseInputMedia struct {
+	// Type of the media, one of MediaType constants.
+	Type string `json:"type"`
+	// FileID is an identifier of a file that exists
+	// on the Telegram servers or HTTP URL for Telegram
+	// to get a file from the Internet.
+	FileID string `json:"-"`
+	// InputFile is uploaded in the same request
+	// and referenced as attach://<name>, if FileID is empty.
+	InputFile InputFile `json:"-"`
+	// Caption of the media to be sent, 0-1024 characters. Optional.
+	Caption string `json:"caption,omitempty"`
+	// Send Markdown or HTML, if you want Telegram apps to show
+	// bold, italic, fixed-width text or inline URLs in the caption.
+	// Optional.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// Special entities that appear in the caption,
+	// which can be specified instead of ParseMode. Optional.
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+}
+
+//
+//      
+func (m BaseInputMedia) Exist() bool
+
+//
+//      
+func (m BaseInputMedia) File() InputFile
+
+//
+//      
+func (m BaseInputMedia) GetFileID() string
+
 //
 //      
 type BaseMessage struct {
@@ -705,6 +1234,54 @@ func (BaseMessage) Message() *Message
 //      
 func (m BaseMessage) Values() (url.Values, error)
 
+//
+//      
+type BotCommand struct {
+	// Text of the command, 1-32 characters.
+	// Can contain only lowercase English letters, digits and underscores.
+	Command string `json:"command"`
+	// Description of the command, 3-256 characters.
+	Description string `json:"description"`
+}
+
+//
+//      
+type BotCommandScope struct {
+	// Scope type, one of ScopeType constants.
+	Type string `json:"type"`
+	// Unique identifier for the target chat. Required for
+	// chat, chat_administrators and chat_member scopes.
+	ChatID int64 `json:"chat_id,omitempty"`
+	// Username of the target supergroup
+	// (in the format @supergroupusername).
+	// Can be used instead of ChatID: if set, it is sent
+	// as a string chat_id and ChatID is ignored.
+	ChannelUsername string `json:"-"`
+	// Unique identifier of the target user.
+	// Required for chat_member scope.
+	UserID int64 `json:"user_id,omitempty"`
+}
+
+//
+//      
+func NewBotCommandScope(scopeType string) *BotCommandScope
+
+//
+//      
+func NewChatAdministratorsScope(chatID int64) *BotCommandScope
+
+//
+//      
+func NewChatMemberScope(chatID, userID int64) *BotCommandScope
+
+//
+//      
+func NewChatScope(chatID int64) *BotCommandScope
+
+//
+//      
+func (s BotCommandScope) MarshalJSON() ([]byte, error)
+
 //
 //      
 type CallbackQuery struct {
@@ -726,8 +1303,26 @@ type CallbackQuery struct {
 
 //
 //      
-type Chat struct {
-	// ID is a Unique identifier for this chat, not exceeding 1e13 by absolute value.
+type CertOptions struct {
+	// Hosts is a list of DNS names and IP addresses
+	// the certificate is issued for. Required.
+	Hosts []string
+	// KeyType is a type of the private key,
+	// one of RSAKeyType, ECDSAKeyType. Defaults to RSAKeyType.
+	KeyType string
+	// RSABits is a size of RSA key. Defaults to 2048.
+	RSABits int
+	// Organization of the certificate subject. Optional.
+	Organization string
+	// ValidFor is a lifetime of the certificate.
+	// Defaults to 10 years.
+	ValidFor time.Duration
+}
+
+//
+//      
+type Chat struct {
+	// ID is a Unique identifier for this chat, not exceeding 1e13 by absolute value.
 	ID int64 `json:"id"`
 	// Type of chat, can be either “private”, “group”, "supergroup" or “channel”
 	Type string `json:"type"`
@@ -765,6 +1360,50 @@ func (cfg ChatActionCfg) Name() string
 //      
 func (cfg ChatActionCfg) Values() (url.Values, error)
 
+//
+//      
+type ChatInviteLink struct {
+	// The invite link.
+	InviteLink string `json:"invite_link"`
+	// Creator of the link.
+	Creator User `json:"creator"`
+	// True, if users joining the chat via the link need
+	// to be approved by chat administrators.
+	CreatesJoinRequest bool `json:"creates_join_request"`
+	// True, if the link is primary.
+	IsPrimary bool `json:"is_primary"`
+	// True, if the link is revoked.
+	IsRevoked bool `json:"is_revoked"`
+	// Invite link name. Optional.
+	Name string `json:"name,omitempty"`
+	// Unix time when the link will expire or has been expired.
+	// Optional.
+	ExpireDate int `json:"expire_date,omitempty"`
+	// Maximum number of users that can be members of the chat
+	// simultaneously after joining the chat via this invite link,
+	// 1-99999. Optional.
+	MemberLimit int `json:"member_limit,omitempty"`
+	// Number of pending join requests created using this link.
+	// Optional.
+	PendingJoinRequestCount int `json:"pending_join_request_count,omitempty"`
+}
+
+//
+//      
+type ChatJoinRequest struct {
+	// Chat to which the request was sent.
+	Chat Chat `json:"chat"`
+	// User that sent the join request.
+	From User `json:"from"`
+	// Date the request was sent in Unix time.
+	Date int `json:"date"`
+	// Bio of the user. Optional.
+	Bio string `json:"bio,omitempty"`
+	// Chat invite link that was used by the user
+	// to send the join request. Optional.
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
 //
 //      
 type ChatMember struct {
@@ -775,6 +1414,24 @@ type ChatMember struct {
 	Status string `json:"status"`
 }
 
+//
+//      
+type ChatMemberUpdated struct {
+	// Chat the user belongs to.
+	Chat Chat `json:"chat"`
+	// Performer of the action, which resulted in the change.
+	From User `json:"from"`
+	// Date the change was done in Unix time.
+	Date int `json:"date"`
+	// Previous information about the chat member.
+	OldChatMember ChatMember `json:"old_chat_member"`
+	// New information about the chat member.
+	NewChatMember ChatMember `json:"new_chat_member"`
+	// Chat invite link, which was used by the user to join the chat;
+	// for joining by invite link events only. Optional.
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
 //
 //      
 type ChosenInlineResult struct {
@@ -786,6 +1443,18 @@ type ChosenInlineResult struct {
 	Query string `json:"query"`
 }
 
+//
+//      
+type CloseCfg struct{}
+
+//
+//      
+func (cfg CloseCfg) Name() string
+
+//
+//      
+func (cfg CloseCfg) Values() (url.Values, error)
+
 //
 //      
 type Contact struct {
@@ -820,6 +1489,61 @@ func (cfg ContactCfg) Values() (url.Values, error)
 //      
 type DebugFunc func(msg string, fields map[string]interface{})
 
+//
+//      
+type DeleteMyCommandsCfg struct {
+	// Scope of users for which the commands are relevant.
+	// Defaults to DefaultScopeType. Optional.
+	Scope *BotCommandScope
+	// A two-letter ISO 639-1 language code or an empty string.
+	LanguageCode string
+}
+
+//
+//      
+func (cfg DeleteMyCommandsCfg) Name() string
+
+//
+//      
+func (cfg DeleteMyCommandsCfg) Values() (url.Values, error)
+
+//
+//      
+type DeleteWebhookCfg struct {
+	// Pass true to drop all pending updates. Optional.
+	DropPendingUpdates bool
+}
+
+//
+//      
+func (cfg DeleteWebhookCfg) Name() string
+
+//
+//      
+func (cfg DeleteWebhookCfg) Values() (url.Values, error)
+
+//
+//      
+type Dispatcher struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewDispatcher(workers, queueSize int, h UpdateHandlerFunc) *Dispatcher
+
+//
+//      
+func (d *Dispatcher) Close(ctx context.Context) error
+
+//
+//      
+func (d *Dispatcher) Dispatch(ctx context.Context, update Update) error
+
+//
+//      
+func (d *Dispatcher) Pending() int
+
 //
 //      
 type Document struct {
@@ -839,6 +1563,9 @@ type Document struct {
 //      
 type DocumentCfg struct {
 	BaseFile
+	Caption string
+	// Special entities that appear in the caption. Optional.
+	CaptionEntities []MessageEntity
 }
 
 //
@@ -853,12 +1580,31 @@ func (cfg DocumentCfg) Name() string
 //      
 func (cfg DocumentCfg) Values() (url.Values, error)
 
+//
+//      
+type DownloadOptions struct {
+	// MaxSize is a maximum size of the file in bytes.
+	// Files with a bigger MetaFile.FileSize are rejected
+	// before downloading. The limit is also checked while copying,
+	// because FileSize is optional: the download stops
+	// with FileSizeError as soon as more than MaxSize bytes
+	// are received. Zero means no limit.
+	MaxSize int64
+	// Retries is a number of attempts to resume the download
+	// with a Range request after a transient error.
+	Retries int
+	// RetryDelay is a delay between attempts. Defaults to 1 second.
+	RetryDelay time.Duration
+}
+
 //
 //      
 type EditMessageCaptionCfg struct {
 	BaseEdit
 	// New caption of the message
 	Caption string
+	// Special entities that appear in the caption. Optional.
+	CaptionEntities []MessageEntity
 }
 
 //
@@ -901,6 +1647,9 @@ type EditMessageTextCfg struct {
 	// to show bold, italic, fixed-width text
 	// or inline URLs in your bot's message. Optional.
 	ParseMode string
+	// Special entities that appear in message text,
+	// which can be specified instead of ParseMode. Optional.
+	Entities []MessageEntity
 	// Disables link previews for links in this message. Optional.
 	DisableWebPagePreview bool
 }
@@ -935,6 +1684,8 @@ type File struct {
 	// FilePath is a relative path to file.
 	// Use https://api.telegram.org/file/bot<token>/<file_path>
 	// to get the file.
+	// For a local Bot API server in --local mode
+	// it's an absolute path to the file on the server machine.
 	FilePath string `json:"file_path,omitempty"`
 
 	// Link is inserted by Api client after GetFile request
@@ -955,6 +1706,55 @@ func (cfg FileCfg) Name() string
 //      
 func (cfg FileCfg) Values() (url.Values, error)
 
+//
+//      
+type FileIDCache interface {
+	// Get returns file_id stored for the key.
+	// Key is a kind of media (Filer.Field(), e.g. photo or document)
+	// and a hex encoded SHA-256 of file content, see FileKey,
+	// so the same file sent as a photo and as a document
+	// gets different file_ids.
+	// Only files which can be read again are hashed:
+	// files created by NewBytesFile and NewFileFromPath.
+	// Other files are uploaded without lookup unless
+	// BaseFile.CacheKey is set.
+	Get(ctx context.Context, key string) (fileID string, ok bool, err error)
+	// Set stores file_id returned by Telegram after the file upload.
+	Set(ctx context.Context, key string, fileID string) error
+}
+
+//
+//      
+type FileOffsetStore struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewFileOffsetStore(path string) *FileOffsetStore
+
+//
+//      
+func (s *FileOffsetStore) Load(ctx context.Context) (int64, error)
+
+//
+//      
+func (s *FileOffsetStore) Save(ctx context.Context, offset int64) error
+
+//
+//      
+type FileSizeError struct {
+	// Size is a size of the file in bytes. If the download
+	// was stopped, it's the number of bytes received so far.
+	Size int64
+	// MaxSize is a limit set by DownloadOptions.
+	MaxSize int64
+}
+
+//
+//      
+func (e *FileSizeError) Error() string
+
 //
 //      
 type Filer interface {
@@ -970,6 +1770,19 @@ type Filer interface {
 	GetFileID() string
 }
 
+//
+//      
+type FloodWaitError struct {
+	*APIError
+	// RetryAfter is a time left to wait before the request
+	// can be repeated, taken from parameters.retry_after.
+	RetryAfter time.Duration
+}
+
+//
+//      
+func (e *FloodWaitError) Error() string
+
 //
 //      
 type ForceReply struct {
@@ -1067,6 +1880,24 @@ func (cfg GetChatMembersCountCfg) Name() string
 //      
 func (cfg GetChatMembersCountCfg) Values() (url.Values, error)
 
+//
+//      
+type GetMyCommandsCfg struct {
+	// Scope of users for which the commands are relevant.
+	// Defaults to DefaultScopeType. Optional.
+	Scope *BotCommandScope
+	// A two-letter ISO 639-1 language code or an empty string.
+	LanguageCode string
+}
+
+//
+//      
+func (cfg GetMyCommandsCfg) Name() string
+
+//
+//      
+func (cfg GetMyCommandsCfg) Values() (url.Values, error)
+
 //
 //      
 type HTTPDoer interface {
@@ -1300,6 +2131,8 @@ type InputContactMessageContent struct {
 //
 //      
 type InputFile interface {
+	// Reader returns file data. API doesn't close the reader,
+	// it's owned by the caller.
 	Reader() io.Reader
 	Name() string
 }
@@ -1312,6 +2145,24 @@ func NewBytesFile(filename string, data []byte) InputFile
 //      
 func NewInputFile(filename string, r io.Reader) InputFile
 
+//
+//      
+type InputFileInfo interface {
+	InputFile
+	// Size returns a size of file in bytes, -1 if unknown.
+	Size() int64
+	// MIMEType returns a content type of file.
+	MIMEType() string
+}
+
+//
+//      
+// NewFileFromPath takes size and MIME type from the file
+// when it's created, but opens the file only when Reader
+// is called. The file opened by NewFileFromPath is closed by API
+// once the upload finishes or fails.
+func NewFileFromPath(path string) (InputFileInfo, error)
+
 //
 //      
 type InputLocationMessageContent struct {
@@ -1319,6 +2170,106 @@ type InputLocationMessageContent struct {
 	Location
 }
 
+//
+//      
+type InputMedia interface {
+	// File data
+	File() InputFile
+	// Exist returns true if file exists on telegram servers
+	Exist() bool
+	// GetFileID returns fileID if it's exist
+	GetFileID() string
+	// MarshalMedia returns the JSON object of the media item
+	// with the media field set to the given value:
+	// FileID or attach://<name> for InputFile.
+	// MediaGroupCfg.Values calls it for every item.
+	MarshalMedia(media string) ([]byte, error)
+}
+
+//
+//      
+type InputMediaAudio struct {
+	BaseInputMedia
+	// Duration of the audio in seconds. Optional.
+	Duration int `json:"duration,omitempty"`
+	// Performer of the audio. Optional.
+	Performer string `json:"performer,omitempty"`
+	// Title of the audio. Optional.
+	Title string `json:"title,omitempty"`
+}
+
+//
+//      
+func NewInputMediaAudio(fileID string) InputMediaAudio
+
+//
+//      
+func NewInputMediaAudioUpload(file InputFile) InputMediaAudio
+
+//
+//      
+func (m InputMediaAudio) MarshalMedia(media string) ([]byte, error)
+
+//
+//      
+type InputMediaDocument struct {
+	BaseInputMedia
+}
+
+//
+//      
+func NewInputMediaDocument(fileID string) InputMediaDocument
+
+//
+//      
+func NewInputMediaDocumentUpload(file InputFile) InputMediaDocument
+
+//
+//      
+func (m InputMediaDocument) MarshalMedia(media string) ([]byte, error)
+
+//
+//      
+type InputMediaPhoto struct {
+	BaseInputMedia
+}
+
+//
+//      
+func NewInputMediaPhoto(fileID string) InputMediaPhoto
+
+//
+//      
+func NewInputMediaPhotoUpload(file InputFile) InputMediaPhoto
+
+//
+//      
+func (m InputMediaPhoto) MarshalMedia(media string) ([]byte, error)
+
+//
+//      
+type InputMediaVideo struct {
+	BaseInputMedia
+	Size
+	// Duration of the video in seconds. Optional.
+	Duration int `json:"duration,omitempty"`
+	// Pass true, if the uploaded video is suitable for streaming.
+	// Optional.
+	SupportsStreaming bool `json:"supports_streaming,omitempty"`
+}
+
+//
+//      
+func NewInputMediaVideo(fileID string) InputMediaVideo
+
+//
+//      
+func NewInputMediaVideoUpload(file InputFile) InputMediaVideo
+
+//
+//      
+func (m InputMediaVideo) MarshalMedia(media string) ([]byte, error)
+
 //
 //      
 type InputMessageContent interface {
@@ -1393,6 +2344,26 @@ func (cfg LeaveChatCfg) Name() string
 //      
 func (cfg LeaveChatCfg) Values() (url.Values, error)
 
+//
+//      
+type Limiter interface {
+	// Wait blocks until a message can be sent to the chat
+	// or ctx is done. Chat is a chat_id value of the request:
+	// a unique identifier of the chat or @channelusername.
+	Wait(ctx context.Context, chat string) error
+}
+
+//
+//      
+type LimiterStats struct {
+	// Queued is a number of requests waiting for a token right now.
+	Queued int
+	// Chats is a number of chats with active buckets.
+	Chats int
+	// Throttled is a total number of requests that had to wait.
+	Throttled int64
+}
+
 //
 //      
 type Location struct {
@@ -1425,6 +2396,18 @@ func (cfg LocationCfg) Name() string
 //      
 func (cfg LocationCfg) Values() (url.Values, error)
 
+//
+//      
+type LogOutCfg struct{}
+
+//
+//      
+func (cfg LogOutCfg) Name() string
+
+//
+//      
+func (cfg LogOutCfg) Values() (url.Values, error)
+
 //
 //      
 type MarkInlineQueryResult struct{}
@@ -1461,6 +2444,73 @@ func (cfg MeCfg) Name() string
 //      
 func (cfg MeCfg) Values() (url.Values, error)
 
+//
+//      
+type MediaGroupCfg struct {
+	BaseChat
+	// Photos, videos, documents or audios to be sent as an album,
+	// 2-10 items. Documents and audios can be only grouped
+	// with items of the same type.
+	Media []InputMedia
+	// If the messages are a reply, ID of the original message
+	ReplyToMessageID int64
+	// Sends the messages silently.
+	// Users will receive a notification with no sound.
+	DisableNotification bool
+}
+
+//
+//      
+func NewMediaGroup(chatID int64, media ...InputMedia) MediaGroupCfg
+
+//
+//      
+func (cfg MediaGroupCfg) Files() map[string]InputFile
+
+//
+//      
+func (cfg MediaGroupCfg) Name() string
+
+//
+//      
+func (cfg MediaGroupCfg) Values() (url.Values, error)
+
+//
+//      
+type MemoryFileIDCache struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewMemoryFileIDCache() *MemoryFileIDCache
+
+//
+//      
+func (c *MemoryFileIDCache) Get(ctx context.Context, key string) (string, bool, error)
+
+//
+//      
+func (c *MemoryFileIDCache) Set(ctx context.Context, key string, fileID string) error
+
+//
+//      
+type MemoryOffsetStore struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewMemoryOffsetStore(offset int64) *MemoryOffsetStore
+
+//
+//      
+func (s *MemoryOffsetStore) Load(ctx context.Context) (int64, error)
+
+//
+//      
+func (s *MemoryOffsetStore) Save(ctx context.Context, offset int64) error
+
 //
 //      
 type Message struct {
@@ -1524,9 +2574,13 @@ type Message struct {
 	// Message is a voice message, information about the file
 	Voice *Voice `json:"voice,omitempty"`
 
-	// Caption for the document, photo or video, 0‐200 characters
+	// Caption for the document, photo or video, 0‐1024 characters
 	Caption string `json:"caption,omitempty"`
 
+	// For messages with a caption, special entities like usernames,
+	// URLs, bot commands, etc. that appear in the caption. Optional.
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
 	// For a contact, contact information itself.
 	Contact *Contact `json:"contact,omitempty"`
 
@@ -1610,16 +2664,45 @@ type Message struct {
 	// will not contain further reply_to_message fields
 	// even if it is itself a reply.
 	PinnedMessage *Message `json:"pinned_message,omitempty"`
+
+	// Raw has the original JSON of the object and unknown keys.
+	// It's nil unless raw retention is enabled,
+	// see API.KeepRaw and UnmarshalUpdate.
+	Raw *RawData `json:"-"`
 }
 
 //
 //      
 func (m *Message) Command() (string, string)
 
+//
+//      
+func (m *Message) Commands() []string
+
+//
+//      
+func (m *Message) EntityText(e MessageEntity) string
+
+//
+//      
+func (m *Message) EntityTexts(entityType string) []string
+
+//
+//      
+func (m *Message) Hashtags() []string
+
 //
 //      
 func (m *Message) IsCommand() bool
 
+//
+//      
+func (m *Message) Mentions() []string
+
+//
+//      
+func (m *Message) URLs() []string
+
 //
 //      
 type MessageCfg struct {
@@ -1629,6 +2712,9 @@ type MessageCfg struct {
 	// bold, italic, fixed-width text or inline URLs in your bot's message.
 	// Use one of constants: ModeHTML, ModeMarkdown.
 	ParseMode string
+	// Special entities that appear in message text,
+	// which can be specified instead of ParseMode. Optional.
+	Entities []MessageEntity
 	// Disables link previews for links in this message.
 	DisableWebPagePreview bool
 }
@@ -1654,7 +2740,9 @@ func (cfg MessageCfg) Values() (url.Values, error)
 type MessageEntity struct {
 	// Type of the entity. One of mention ( @username ), hashtag,
 	// bot_command, url, email, bold (bold text),
-	// italic (italic text), code (monowidth string),
+	// italic (italic text), underline (underlined text),
+	// strikethrough (strikethrough text), spoiler (spoiler message),
+	// code (monowidth string),
 	// pre (monowidth block), text_link (for clickable text URLs),
 	// text_mention (for users without usernames)
 	// Use constants SomethingEntityType instead of string.
@@ -1668,8 +2756,19 @@ type MessageEntity struct {
 	URL string `json:"url,omitempty"`
 	// For “text_mention” only, the mentioned user. Optional.
 	User *User `json:"user,omitempty"`
+	// For “pre” only, the programming language
+	// of the entity text. Optional.
+	Language string `json:"language,omitempty"`
 }
 
+//
+//      
+func (e MessageEntity) Bounds(text string) (start, end int)
+
+//
+//      
+func (e MessageEntity) Substring(text string) string
+
 //
 //      
 type Messenger interface {
@@ -1679,6 +2778,8 @@ type Messenger interface {
 
 //
 //      
+// CloneMessage copies Message.Entities and Message.CaptionEntities
+// to the config, so the cloned message keeps its formatting.
 func CloneMessage(msg *Message, baseMessage *BaseMessage) Messenger
 
 //
@@ -1699,11 +2800,50 @@ type Method interface {
 	Values() (url.Values, error)
 }
 
+//
+//      
+type OffsetStore interface {
+	// Load returns an identifier of the first update
+	// to be requested with getUpdates.
+	// Zero means nothing was saved yet.
+	Load(ctx context.Context) (int64, error)
+	// Save stores an offset once all updates
+	// with lower identifiers are handled.
+	Save(ctx context.Context, offset int64) error
+}
+
+//
+//      
+type Option func(*API)
+
+//
+//      
+func WithEndpoint(apiEndpoint, fileEndpoint string) Option
+
+//
+//      
+func WithLocalMode() Option
+
+//
+//      
+type OrderInfo struct {
+	// User name. Optional.
+	Name string `json:"name,omitempty"`
+	// User's phone number. Optional.
+	PhoneNumber string `json:"phone_number,omitempty"`
+	// User email. Optional.
+	Email string `json:"email,omitempty"`
+	// User shipping address. Optional.
+	ShippingAddress *ShippingAddress `json:"shipping_address,omitempty"`
+}
+
 //
 //      
 type PhotoCfg struct {
 	BaseFile
 	Caption string
+	// Special entities that appear in the caption. Optional.
+	CaptionEntities []MessageEntity
 }
 
 //
@@ -1733,6 +2873,141 @@ type PhotoSize struct {
 	Size
 }
 
+//
+//      
+type Poll struct {
+	// ID is a unique poll identifier.
+	ID string `json:"id"`
+	// Question is a poll question, 1-300 characters.
+	Question string `json:"question"`
+	// Options is a list of poll options.
+	Options []PollOption `json:"options"`
+	// Total number of users that voted in the poll.
+	TotalVoterCount int `json:"total_voter_count"`
+	// True, if the poll is closed.
+	IsClosed bool `json:"is_closed"`
+	// True, if the poll is anonymous.
+	IsAnonymous bool `json:"is_anonymous"`
+	// Poll type, currently can be “regular” or “quiz”.
+	Type string `json:"type"`
+	// True, if the poll allows multiple answers.
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers"`
+	// 0-based identifier of the correct answer option.
+	// Available only for polls in the quiz mode,
+	// which are closed, or was sent by the bot. Optional.
+	CorrectOptionID *int `json:"correct_option_id,omitempty"`
+}
+
+//
+//      
+type PollAnswer struct {
+	// Unique poll identifier.
+	PollID string `json:"poll_id"`
+	// The user, who changed the answer to the poll.
+	User User `json:"user"`
+	// 0-based identifiers of answer options, chosen by the user.
+	// May be empty if the user retracted their vote.
+	OptionIDs []int `json:"option_ids"`
+}
+
+//
+//      
+type PollOption struct {
+	// Option text, 1-100 characters.
+	Text string `json:"text"`
+	// Number of users that voted for this option.
+	VoterCount int `json:"voter_count"`
+}
+
+//
+//      
+type Poller struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewPoller(api *API, cfg UpdateCfg, store OffsetStore) *Poller
+
+//
+//      
+func (p *Poller) Concurrency(workers, queueSize int)
+
+//
+//      
+func (p *Poller) Serve(ctx context.Context, h UpdateHandlerFunc) error
+
+//
+//      
+func (p *Poller) Shutdown(ctx context.Context) error
+
+//
+//      
+type PreCheckoutQuery struct {
+	// Unique query identifier.
+	ID string `json:"id"`
+	// User who sent the query.
+	From User `json:"from"`
+	// Three-letter ISO 4217 currency code.
+	Currency string `json:"currency"`
+	// Total price in the smallest units of the currency.
+	TotalAmount int `json:"total_amount"`
+	// Bot specified invoice payload.
+	InvoicePayload string `json:"invoice_payload"`
+	// Identifier of the shipping option chosen by the user. Optional.
+	ShippingOptionID string `json:"shipping_option_id,omitempty"`
+	// Order info provided by the user. Optional.
+	OrderInfo *OrderInfo `json:"order_info,omitempty"`
+}
+
+//
+//      
+type ProgressFunc func(file InputFile, sent, total int64)
+
+//
+//      
+type RateLimiter struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewRateLimiter(limits RateLimits) *RateLimiter
+
+//
+//      
+func (l *RateLimiter) Stats() LimiterStats
+
+//
+//      
+func (l *RateLimiter) Wait(ctx context.Context, chat string) error
+
+//
+//      
+type RateLimits struct {
+	// Global is a number of messages per second allowed for all chats.
+	Global float64
+	// Private is a number of messages per second allowed
+	// for a single private chat (positive chat identifier).
+	Private float64
+	// Group is a number of messages per second allowed
+	// for a single group, supergroup or channel
+	// (negative chat identifier or @channelusername).
+	Group float64
+	// Burst is a maximum number of messages sent at once
+	// to a single chat. Defaults to 1.
+	Burst int
+}
+
+//
+//      
+type RawData struct {
+	// JSON is the original JSON of the object.
+	JSON json.RawMessage
+	// Extra has JSON values of keys unknown to this package.
+	Extra map[string]json.RawMessage
+}
+
 //
 //      
 type ReplyKeyboardHide struct {
@@ -1792,6 +3067,126 @@ func NewRequiredError(fields ...string) *RequiredError
 //      
 func (e *RequiredError) Error() string
 
+//
+//      
+type ResponseParameters struct {
+	// The group has been migrated to a supergroup
+	// with the specified identifier. Optional.
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+	// In case of exceeding flood control, the number of seconds
+	// left to wait before the request can be repeated. Optional.
+	RetryAfter int `json:"retry_after,omitempty"`
+}
+
+//
+//      
+type RetryPolicy struct {
+	// MaxRetries is a number of times a request is repeated
+	// after FloodWaitError. Zero disables retries.
+	MaxRetries int
+	// MaxWait is the longest retry_after API agrees to sleep.
+	// Errors with a longer wait are returned immediately.
+	// Zero means no limit.
+	MaxWait time.Duration
+	// Methods overrides MaxRetries for the given API methods,
+	// e.g. {"sendMessage": 3, "answerCallbackQuery": 0}. Optional.
+	Methods map[string]int
+}
+
+//
+//      
+func (p RetryPolicy) Retries(method string) int
+
+//
+//      
+type SelfSignedCert struct {
+	// CertPEM is a PEM encoded certificate.
+	// Upload it with NewWebhookWithCert.
+	CertPEM []byte
+	// KeyPEM is a PEM encoded private key.
+	KeyPEM []byte
+}
+
+//
+//      
+func NewSelfSignedCert(opts CertOptions) (*SelfSignedCert, error)
+
+//
+//      
+func (c *SelfSignedCert) File() InputFile
+
+//
+//      
+func (c *SelfSignedCert) TLSConfig() (*tls.Config, error)
+
+//
+//      
+func (c *SelfSignedCert) WriteFiles(certFile, keyFile string) error
+
+//
+//      
+type SetMyCommandsCfg struct {
+	// A list of bot commands to be set, at most 100 commands.
+	Commands []BotCommand
+	// Scope of users for which the commands are relevant.
+	// Defaults to DefaultScopeType. Optional.
+	Scope *BotCommandScope
+	// A two-letter ISO 639-1 language code. If empty,
+	// commands will be applied to all users from the given scope,
+	// for whose language there are no dedicated commands. Optional.
+	LanguageCode string
+}
+
+//
+//      
+func (cfg SetMyCommandsCfg) Name() string
+
+//
+//      
+func (cfg SetMyCommandsCfg) Values() (url.Values, error)
+
+//
+//      
+type ShippingAddress struct {
+	// ISO 3166-1 alpha-2 country code.
+	CountryCode string `json:"country_code"`
+	// State, if applicable.
+	State string `json:"state"`
+	// City.
+	City string `json:"city"`
+	// First line for the address.
+	StreetLine1 string `json:"street_line1"`
+	// Second line for the address.
+	StreetLine2 string `json:"street_line2"`
+	// Address post code.
+	PostCode string `json:"post_code"`
+}
+
+//
+//      
+type ShippingQuery struct {
+	// Unique query identifier.
+	ID string `json:"id"`
+	// User who sent the query.
+	From User `json:"from"`
+	// Bot specified invoice payload.
+	InvoicePayload string `json:"invoice_payload"`
+	// User specified shipping address.
+	ShippingAddress ShippingAddress `json:"shipping_address"`
+}
+
+//
+//      
+type ShutdownError struct {
+	// Unfinished are updates which handlers didn't return
+	// before the shutdown deadline. Their offsets are not committed.
+	Unfinished []Update
+}
+
+//
+//      
+func (e *ShutdownError) Error() string
+
 //
 //      
 type Size struct {
@@ -1799,6 +3194,36 @@ type Size struct {
 	Height int `json:"height"`
 }
 
+//
+//      
+type SourceOptions struct {
+	// Mode is one of PollingMode, WebhookMode.
+	// Defaults to PollingMode.
+	Mode string
+	// Update is used to request updates in PollingMode.
+	Update UpdateCfg
+	// Store keeps the polling offset between restarts. Optional.
+	Store OffsetStore
+	// Webhook is registered with setWebhook in WebhookMode.
+	Webhook WebhookCfg
+	// Mux gets WebhookHandler registered on the path of Webhook.URL,
+	// other routes of the mux are kept.
+	// Defaults to http.DefaultServeMux.
+	Mux *http.ServeMux
+	// Server is started in WebhookMode and shut down on Shutdown
+	// or when Supervisor switches to PollingMode.
+	// Its Handler is not changed and has to route requests to Mux.
+	// Optional, if nil the caller serves Mux on their own.
+	Server *http.Server
+	// CheckInterval is how often getWebhookInfo is requested
+	// in WebhookMode. Defaults to 1 minute.
+	CheckInterval time.Duration
+	// MaxWebhookErrors is a number of consecutive checks
+	// with a new WebhookInfo.LastErrorDate, after which
+	// Supervisor switches to PollingMode. Zero disables the fallback.
+	MaxWebhookErrors int
+}
+
 //
 //      
 type Sticker struct {
@@ -1829,6 +3254,94 @@ func (cfg StickerCfg) Name() string
 //      
 func (cfg StickerCfg) Values() (url.Values, error)
 
+//
+//      
+type Supervisor struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewSupervisor(api *API, opts SourceOptions) *Supervisor
+
+//
+//      
+func (s *Supervisor) Mode() string
+
+//
+//      
+func (s *Supervisor) Serve(ctx context.Context, h UpdateHandlerFunc) error
+
+//
+//      
+func (s *Supervisor) Shutdown(ctx context.Context) error
+
+//
+//      
+func (s *Supervisor) Switch(ctx context.Context, mode string) error
+
+//
+//      
+type TextBuilder struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewTextBuilder(parseMode string) *TextBuilder
+
+//
+//      
+func (b *TextBuilder) Bold(text string) *TextBuilder
+
+//
+//      
+func (b *TextBuilder) Code(text string) *TextBuilder
+
+//
+//      
+func (b *TextBuilder) Err() error
+
+//
+//      
+func (b *TextBuilder) Italic(text string) *TextBuilder
+
+//
+//      
+func (b *TextBuilder) Len() int
+
+//
+//      
+func (b *TextBuilder) Link(text, url string) *TextBuilder
+
+//
+//      
+func (b *TextBuilder) Mention(user User) *TextBuilder
+
+//
+//      
+func (b *TextBuilder) Message(chatID int64) (MessageCfg, error)
+
+//
+//      
+func (b *TextBuilder) Pre(language, text string) *TextBuilder
+
+//
+//      
+func (b *TextBuilder) Spoiler(text string) *TextBuilder
+
+//
+//      
+func (b *TextBuilder) String() string
+
+//
+//      
+func (b *TextBuilder) Text(text string) *TextBuilder
+
+//
+//      
+func (b *TextBuilder) Textf(format string, args ...interface{}) *TextBuilder
+
 //
 //      
 type UnbanChatMemberCfg struct {
@@ -1857,6 +3370,12 @@ type Update struct {
 	// New version of a message that is known to the bot and was edited.
 	// Optional.
 	EditedMessage *Message `json:"edited_message,omitempty"`
+	// ChannelPost is a new incoming channel post of any kind:
+	// text, photo, sticker, etc. Optional.
+	ChannelPost *Message `json:"channel_post,omitempty"`
+	// New version of a channel post that is known to the bot
+	// and was edited. Optional.
+	EditedChannelPost *Message `json:"edited_channel_post,omitempty"`
 	// InlineQuery is a new incoming inline query. Optional.
 	InlineQuery *InlineQuery `json:"inline_query,omitempty"`
 	// ChosenInlineResult is a result of an inline query
@@ -1864,8 +3383,41 @@ type Update struct {
 	ChosenInlineResult *ChosenInlineResult `json:"chosen_inline_result,omitempty"`
 	// CallbackQuery is a new incoming callback query. Optional.
 	CallbackQuery *CallbackQuery `json:"callback_query,omitempty"`
+	// ShippingQuery is a new incoming shipping query.
+	// Only for invoices with flexible price. Optional.
+	ShippingQuery *ShippingQuery `json:"shipping_query,omitempty"`
+	// PreCheckoutQuery is a new incoming pre-checkout query.
+	// Contains full information about checkout. Optional.
+	PreCheckoutQuery *PreCheckoutQuery `json:"pre_checkout_query,omitempty"`
+	// Poll is a new poll state. Bots receive only updates
+	// about stopped polls and polls, which are sent by the bot.
+	// Optional.
+	Poll *Poll `json:"poll,omitempty"`
+	// PollAnswer is a changed answer of a user in a non-anonymous poll.
+	// Bots receive new votes only in polls that were sent
+	// by the bot itself. Optional.
+	PollAnswer *PollAnswer `json:"poll_answer,omitempty"`
+	// MyChatMember is the bot's chat member status
+	// that was updated in a chat. Optional.
+	MyChatMember *ChatMemberUpdated `json:"my_chat_member,omitempty"`
+	// ChatMember is a chat member's status that was updated in a chat.
+	// The bot must be an administrator in the chat and must explicitly
+	// specify “chat_member” in the list of AllowedUpdates. Optional.
+	ChatMember *ChatMemberUpdated `json:"chat_member,omitempty"`
+	// ChatJoinRequest is a request to join the chat
+	// that has been sent. Optional.
+	ChatJoinRequest *ChatJoinRequest `json:"chat_join_request,omitempty"`
+
+	// Raw has the original JSON of the object and unknown keys.
+	// It's nil unless raw retention is enabled,
+	// see API.KeepRaw and UnmarshalUpdate.
+	Raw *RawData `json:"-"`
 }
 
+//
+//      
+func UnmarshalUpdate(data []byte, keepRaw bool) (*Update, error)
+
 //
 //      
 func (u Update) Chat() (chat *Chat)
@@ -1882,6 +3434,10 @@ func (u Update) HasMessage() bool
 //      
 func (u Update) IsEdited() bool
 
+//
+//      
+func (u Update) Kind() UpdateKind
+
 //
 //      
 type UpdateCfg struct {
@@ -1902,6 +3458,15 @@ type UpdateCfg struct {
 	// Timeout in seconds for long polling.
 	// Defaults to 0, i.e. usual short polling
 	Timeout int
+	// List of the update types you want your bot to receive,
+	// use UpdateType constants, e.g. MessageUpdateType.
+	// Values sends allowed_updates only if the list isn't empty,
+	// so nil or empty list keeps the previous setting. Optional.
+	AllowedUpdates []string
+	// Pass true to send an empty allowed_updates list, which resets
+	// the filter to all update types except chat_member.
+	// AllowedUpdates is ignored then. Optional.
+	ResetAllowedUpdates bool
 }
 
 //
@@ -1918,24 +3483,70 @@ func (cfg UpdateCfg) Values() (url.Values, error)
 
 //
 //      
-type User struct {
-	// ID is a unique identifier for this user or bot.
-	ID int64 `json:"id"`
-	// FirstName is a user‘s or bot’s first name
-	FirstName string `json:"first_name"`
-	// LastName is a user‘s or bot’s last name. Optional.
-	LastName string `json:"last_name,omitempty"`
-	// Username is a user‘s or bot’s username. Optional.
-	Username string `json:"username,omitempty"`
-}
+type UpdateHandlerFunc func(ctx context.Context, update Update) error
 
 //
 //      
-type UserProfilePhotos struct {
-	// Total number of profile pictures the target user has
-	TotalCount int `json:"total_count"`
-	// Requested profile pictures (in up to 4 sizes each)
-	Photos [][]PhotoSize `json:"photos"`
+type UpdateKind int
+
+//View Source
+const (
+	UnknownUpdate UpdateKind = iota
+	MessageUpdate
+	EditedMessageUpdate
+	ChannelPostUpdate
+	EditedChannelPostUpdate
+	InlineQueryUpdate
+	ChosenInlineResultUpdate
+	CallbackQueryUpdate
+	ShippingQueryUpdate
+	PreCheckoutQueryUpdate
+	PollUpdate
+	PollAnswerUpdate
+	MyChatMemberUpdate
+	ChatMemberUpdate
+	ChatJoinRequestUpdate
+)
+
+//
+//      
+// String returns the name of the update type used by the Bot API,
+// the same as the matching UpdateType constant, e.g. "channel_post"
+// for ChannelPostUpdate, so it can be put in AllowedUpdates.
+// UnknownUpdate returns an empty string.
+func (k UpdateKind) String() string
+
+//
+//      
+type UpdateSource interface {
+	// Serve passes received updates to h
+	// until ctx is done or Shutdown is called.
+	Serve(ctx context.Context, h UpdateHandlerFunc) error
+	// Shutdown stops receiving updates
+	// and waits for running handlers to finish.
+	Shutdown(ctx context.Context) error
+}
+
+//
+//      
+type User struct {
+	// ID is a unique identifier for this user or bot.
+	ID int64 `json:"id"`
+	// FirstName is a user‘s or bot’s first name
+	FirstName string `json:"first_name"`
+	// LastName is a user‘s or bot’s last name. Optional.
+	LastName string `json:"last_name,omitempty"`
+	// Username is a user‘s or bot’s username. Optional.
+	Username string `json:"username,omitempty"`
+}
+
+//
+//      
+type UserProfilePhotos struct {
+	// Total number of profile pictures the target user has
+	TotalCount int `json:"total_count"`
+	// Requested profile pictures (in up to 4 sizes each)
+	Photos [][]PhotoSize `json:"photos"`
 }
 
 //
@@ -2030,6 +3641,8 @@ type VideoCfg struct {
 	BaseFile
 	Duration int
 	Caption  string
+	// Special entities that appear in the caption. Optional.
+	CaptionEntities []MessageEntity
 }
 
 //
@@ -2080,6 +3693,29 @@ type WebhookCfg struct {
 	URL string
 	// self generated TLS certificate
 	Certificate InputFile
+	// A secret token to be sent in a header
+	// “X-Telegram-Bot-Api-Secret-Token” in every webhook request,
+	// 1-256 characters. Only characters A-Z, a-z, 0-9, _ and -
+	// are allowed. Optional.
+	SecretToken string
+	// The fixed IP address which will be used to send webhook requests
+	// instead of the IP address resolved through DNS. Optional.
+	IPAddress string
+	// Maximum allowed number of simultaneous HTTPS connections
+	// to the webhook for update delivery, 1-100. Defaults to 40.
+	// Optional.
+	MaxConnections int
+	// List of the update types you want your bot to receive,
+	// use UpdateType constants, e.g. MessageUpdateType.
+	// Values sends allowed_updates only if the list isn't empty,
+	// so nil or empty list keeps the previous setting. Optional.
+	AllowedUpdates []string
+	// Pass true to send an empty allowed_updates list, which resets
+	// the filter to all update types except chat_member.
+	// AllowedUpdates is ignored then. Optional.
+	ResetAllowedUpdates bool
+	// Pass true to drop all pending updates. Optional.
+	DropPendingUpdates bool
 }
 
 //
@@ -2118,4 +3754,562 @@ func (cfg *WebhookCfg) Reset(i InputFile)
 //      
 func (cfg WebhookCfg) Values() (url.Values, error)
 
+//
+//      
+type WebhookHandler struct {
+	// SecretToken is compared in constant time with SecretTokenHeader
+	// of every request, requests with a different value are rejected
+	// with 403 Forbidden. Use the same value as in WebhookCfg.SecretToken.
+	// All requests are rejected if it's empty, unless Insecure is set.
+	SecretToken string
+	// Insecure disables the secret token verification.
+	// Anyone who knows the webhook URL can send forged updates,
+	// so use it only behind another authentication layer.
+	Insecure bool
+	// MaxBodySize limits the size of request body in bytes.
+	// Defaults to DefaultWebhookBodySize.
+	MaxBodySize int64
+	// KeepRaw enables retention of the original JSON
+	// and unknown keys in Update.Raw.
+	KeepRaw bool
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewWebhookHandler(secretToken string, out chan<- Update) *WebhookHandler
+
+//
+//      
+func NewWebhookHandlerFunc(secretToken string, h UpdateHandlerFunc) *WebhookHandler
+
+//
+//      
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
+
+//
+//      
+type WebhookInfo struct {
+	// Webhook URL, may be empty if webhook is not set up.
+	URL string `json:"url"`
+	// True, if a custom certificate was provided
+	// for webhook certificate checks.
+	HasCustomCertificate bool `json:"has_custom_certificate"`
+	// Number of updates awaiting delivery.
+	PendingUpdateCount int `json:"pending_update_count"`
+	// Currently used webhook IP address. Optional.
+	IPAddress string `json:"ip_address,omitempty"`
+	// Unix time for the most recent error that happened
+	// when trying to deliver an update via webhook. Optional.
+	LastErrorDate int `json:"last_error_date,omitempty"`
+	// Error message in human-readable format for the most recent error
+	// that happened when trying to deliver an update via webhook.
+	// Optional.
+	LastErrorMessage string `json:"last_error_message,omitempty"`
+	// Unix time of the most recent error that happened
+	// when trying to synchronize available updates
+	// with Telegram datacenters. Optional.
+	LastSynchronizationErrorDate int `json:"last_synchronization_error_date,omitempty"`
+	// Maximum allowed number of simultaneous HTTPS connections
+	// to the webhook for update delivery. Optional.
+	MaxConnections int `json:"max_connections,omitempty"`
+	// A list of update types the bot is subscribed to.
+	// Defaults to all update types except chat_member. Optional.
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+//
+//      
+func (info WebhookInfo) IsSet() bool
+
+//
+//      
+type WebhookInfoCfg struct{}
+
+//
+//      
+func (cfg WebhookInfoCfg) Name() string
+
+//
+//      
+func (cfg WebhookInfoCfg) Values() (url.Values, error)
+
+//
+//      
+type WebhookServer struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewWebhookServer(
+	api *API,
+	cfg WebhookCfg,
+	mux *http.ServeMux,
+	srv *http.Server) *WebhookServer
+
+//
+//      
+func (s *WebhookServer) Serve(ctx context.Context, h UpdateHandlerFunc) error
+
+//
+//      
+func (s *WebhookServer) Shutdown(ctx context.Context) error
+
+//
+//        import "github.com/bot-api/telegram/telebot"
+//      
+
+//View Source
+const (
+	// EndState finishes the conversation when returned as the next
+	// state. The name is reserved, Conversation.State panics
+	// if a state is registered with it.
+	EndState = "end"
+	// CancelCommand finishes the conversation in any state.
+	// Other commands bypass the conversation: they are passed
+	// to the next handler and the state is kept.
+	CancelCommand = "cancel"
+)
+
+//View Source
+const (
+	TextContent     = "text"
+	PhotoContent    = "photo"
+	VideoContent    = "video"
+	AudioContent    = "audio"
+	VoiceContent    = "voice"
+	DocumentContent = "document"
+	StickerContent  = "sticker"
+	LocationContent = "location"
+	VenueContent    = "venue"
+	ContactContent  = "contact"
+)
+
+//
+//      
+func BotCommands(specs ...CommandSpec) []telegram.BotCommand
+
+//
+//      
+func CommandArg(ctx context.Context) string
+
+//
+//      
+func CommandSpecs(specs ...CommandSpec) map[string]Commander
+
+//
+//      
+func ConversationData(ctx context.Context) map[string]string
+
+//
+//      
+func GetAPI(ctx context.Context) *telegram.API
+
+//
+//      
+func GetUpdate(ctx context.Context) *telegram.Update
+
+//
+//      
+func Matches(ctx context.Context) []string
+
+//
+//      
+func Params(ctx context.Context) map[string]string
+
+//
+//      
+func PublishCommands(
+	ctx context.Context,
+	api *telegram.API,
+	scope *telegram.BotCommandScope,
+	languageCode string,
+	specs ...CommandSpec) error
+
+//
+//      
+func SyncCommands(
+	ctx context.Context,
+	api *telegram.API,
+	scope *telegram.BotCommandScope,
+	languageCode string,
+	commands map[string]Commander) error
+
+//
+//      
+type ArgError struct {
+	// Command is a name of the command.
+	Command string
+	// Arg is a name of the invalid argument or flag.
+	Arg string
+	// Description of the problem.
+	Description string
+}
+
+//
+//      
+func (e *ArgError) Error() string
+
+//
+//      
+type Bot struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewWithAPI(api *telegram.API) *Bot
+
+//
+//      
+func (b *Bot) Concurrency(workers, queueSize int)
+
+//
+//      
+func (b *Bot) Handle(handler Handler)
+
+//
+//      
+func (b *Bot) HandleFunc(handler HandlerFunc)
+
+//
+//      
+func (b *Bot) Serve(ctx context.Context) error
+
+//
+//      
+func (b *Bot) Shutdown(ctx context.Context) error
+
+//
+//      
+func (b *Bot) Use(middleware ...MiddlewareFunc)
+
+//
+//      
+type BotCommander interface {
+	Commander
+	// BotCommand returns the command with its description
+	// for the bot command menu. Empty Command is replaced
+	// with the name the commander is registered with.
+	// SyncCommands skips commanders which don't implement
+	// BotCommander, they are handled but not shown in the menu.
+	BotCommand() telegram.BotCommand
+}
+
+//
+//      
+func Describe(description string, c Commander) BotCommander
+
+//
+//      
+type CommandFunc func(ctx context.Context, arg string) error
+
+//
+//      
+func (f CommandFunc) Command(ctx context.Context, arg string) error
+
+//
+//      
+type CommandSpec struct {
+	// Name of the command without leading slash, 1-32 characters.
+	Name string
+	// Description of the command, 3-256 characters.
+	// It's shown in the usage message and published with setMyCommands.
+	Description string
+	// Args is a struct value which describes arguments of the command.
+	// Fields tagged `arg:"name"` are positional arguments
+	// in the order of declaration, fields tagged `flag:"name"`
+	// are -name=value flags. Pointer fields are optional.
+	// Supported types are string, bool, int, int64, float64
+	// and time.Duration. A "quoted string" is a single argument.
+	Args interface{}
+	// Handler gets a pointer to a new value of Args type
+	// filled with the command arguments.
+	Handler func(ctx context.Context, args interface{}) error
+}
+
+//
+//      
+func (s CommandSpec) BotCommand() telegram.BotCommand
+
+//
+//      
+func (s CommandSpec) Command(ctx context.Context, arg string) error
+
+//
+//      
+func (s CommandSpec) Parse(arg string) (interface{}, error)
+
+//
+//      
+func (s CommandSpec) Usage() string
+
+//
+//      
+type Commander interface {
+	Command(ctx context.Context, arg string) error
+}
+
+//
+//      
+type Conversation struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewConversation(start string, store StateStore) *Conversation
+
+//
+//      
+func (c *Conversation) Cancel(ctx context.Context) error
+
+//
+//      
+func (c *Conversation) Middleware() MiddlewareFunc
+
+//
+//      
+func (c *Conversation) Start(ctx context.Context) error
+
+//
+//      
+// State registers a state with the given name. It panics if name
+// is empty or EndState, or if the state is already registered.
+func (c *Conversation) State(name string, state State) *Conversation
+
+//
+//      
+type ConversationKey struct {
+	// ChatID is an identifier of the chat, taken from Update.Chat().
+	ChatID int64
+	// UserID is an identifier of the user, taken from Update.From().
+	UserID int64
+}
+
+//
+//      
+func NewConversationKey(update *telegram.Update) ConversationKey
+
+//
+//      
+type Handler interface {
+	Handle(ctx context.Context) error
+}
+
+//
+//      
+type HandlerFunc func(ctx context.Context) error
+
+//
+//      
+func (f HandlerFunc) Handle(ctx context.Context) error
+
+//
+//      
+type MemoryStateStore struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewMemoryStateStore() *MemoryStateStore
+
+//
+//      
+func (s *MemoryStateStore) Delete(ctx context.Context, key ConversationKey) error
+
+//
+//      
+func (s *MemoryStateStore) Get(ctx context.Context, key ConversationKey) (*StateRecord, error)
+
+//
+//      
+func (s *MemoryStateStore) Set(ctx context.Context, key ConversationKey, record StateRecord) error
+
+//
+//      
+type MiddlewareFunc func(next Handler) Handler
+
+//
+//      
+func Commands(commands map[string]Commander) MiddlewareFunc
+
+//
+//      
+func Recover() MiddlewareFunc
+
+//
+//      
+type Route struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func (r *Route) ChatType(types ...string) *Route
+
+//
+//      
+type Router struct {
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewRouter(botUsername string) *Router
+
+//
+//      
+func (r *Router) Callback(prefix string, h HandlerFunc) *Route
+
+//
+//      
+func (r *Router) CallbackPattern(pattern string, h HandlerFunc) *Route
+
+//
+//      
+func (r *Router) Command(name string, h HandlerFunc) *Route
+
+//
+//      
+func (r *Router) Content(contentType string, h HandlerFunc) *Route
+
+//
+//      
+func (r *Router) Fallback(h HandlerFunc)
+
+//
+//      
+func (r *Router) Middleware() MiddlewareFunc
+
+//
+//      
+func (r *Router) Regexp(re *regexp.Regexp, h HandlerFunc) *Route
+
+//
+//      
+type State struct {
+	// Prompt is called when the conversation enters the state,
+	// e.g. to ask a question. Returning the current state name
+	// from a handler re-enters the state and calls Prompt again.
+	// Optional.
+	Prompt HandlerFunc
+	// OnText handles a text message and returns the next state.
+	// If a handler returns an error or an empty state name,
+	// the conversation stays in the current state without
+	// calling Prompt. Optional.
+	OnText func(ctx context.Context, text string) (string, error)
+	// OnLocation handles a location message and returns
+	// the next state. Optional.
+	OnLocation func(ctx context.Context, location telegram.Location) (string, error)
+	// OnContact handles a contact message and returns
+	// the next state. Optional.
+	OnContact func(ctx context.Context, contact telegram.Contact) (string, error)
+	// OnCallback handles a callback query data and returns
+	// the next state. Optional.
+	OnCallback func(ctx context.Context, data string) (string, error)
+	// Timeout finishes the conversation if the user doesn't answer.
+	// Zero means no timeout.
+	Timeout time.Duration
+}
+
+//
+//      
+type StateRecord struct {
+	// State is a name of the current state.
+	State string
+	// Data keeps values between states, see ConversationData.
+	Data map[string]string
+	// Expires is a time the state times out, zero if never.
+	Expires time.Time
+}
+
+//
+//      
+type StateStore interface {
+	// Get returns a state of the conversation,
+	// nil if there is no conversation with the key.
+	Get(ctx context.Context, key ConversationKey) (*StateRecord, error)
+	// Set saves a state of the conversation.
+	Set(ctx context.Context, key ConversationKey, record StateRecord) error
+	// Delete finishes the conversation.
+	Delete(ctx context.Context, key ConversationKey) error
+}
+
+//
+//        import "github.com/bot-api/telegram/telegramtest"
+//      
+
+//
+//      
+type Call struct {
+	// Method is a name of called API method, e.g. sendMessage.
+	Method string
+	// Params are parameters of the request.
+	Params url.Values
+	// Files are uploaded files by field name.
+	Files map[string][]byte
+}
+
+//
+//      
+type HandlerFunc func(call Call) (interface{}, error)
+
+//
+//      
+type Server struct {
+	// Me is a bot returned by getMe.
+	Me telegram.User
+	// contains filtered or unexported fields
+}
+
+//
+//      
+func NewServer(token string) *Server
+
+//
+//      
+func (s *Server) API() *telegram.API
+
+//
+//      
+func (s *Server) AddFile(file telegram.File, data []byte)
+
+//
+//      
+func (s *Server) AddUpdate(updates ...telegram.Update)
+
+//
+//      
+func (s *Server) Calls(method string) []Call
+
+//
+//      
+func (s *Server) Client() telegram.HTTPDoer
+
+//
+//      
+func (s *Server) Close()
+
+//
+//      
+func (s *Server) Endpoint() telegram.Option
+
+//
+//      
+func (s *Server) Fail(method string, err telegram.APIError)
+
+//
+//      
+func (s *Server) Handle(method string, h HandlerFunc)
+
+//
+//      
+func (s *Server) URL() string
+
+//
+//      
+func (s *Server) WaitCalls(ctx context.Context, method string, n int) ([]Call, error)
 